@@ -0,0 +1,176 @@
+package cardano
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// BlockfrostClient is a Node implementation backed by the Blockfrost REST
+// API (https://blockfrost.io), useful when the caller doesn't want to run
+// their own node.
+type BlockfrostClient struct {
+	baseURL string
+	apiKey  string
+	http    *http.Client
+}
+
+// NewBlockfrostClient returns a BlockfrostClient for the given network
+// baseURL (e.g. "https://cardano-mainnet.blockfrost.io/api/v0") authenticated
+// with apiKey.
+func NewBlockfrostClient(baseURL, apiKey string) *BlockfrostClient {
+	return &BlockfrostClient{baseURL: strings.TrimRight(baseURL, "/"), apiKey: apiKey, http: &http.Client{}}
+}
+
+func (c *BlockfrostClient) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("project_id", c.apiKey)
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("blockfrost: %s: %s", res.Status, body)
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+func (c *BlockfrostClient) ProtocolParameters(ctx context.Context) (*ProtocolParams, error) {
+	var resp blockfrostEpochParams
+	if err := c.get(ctx, "/epochs/latest/parameters", &resp); err != nil {
+		return nil, err
+	}
+	return resp.toProtocolParams(), nil
+}
+
+func (c *BlockfrostClient) UTxOs(ctx context.Context, addr Address) ([]*UTxO, error) {
+	var resp []blockfrostUTxO
+	if err := c.get(ctx, "/addresses/"+addr.Bech32()+"/utxos", &resp); err != nil {
+		return nil, err
+	}
+
+	utxos := make([]*UTxO, len(resp))
+	for i, u := range resp {
+		utxo, err := u.toUTxO(addr)
+		if err != nil {
+			return nil, err
+		}
+		utxos[i] = utxo
+	}
+	return utxos, nil
+}
+
+func (c *BlockfrostClient) Tip(ctx context.Context) (Slot, error) {
+	var resp struct {
+		Slot uint64 `json:"slot"`
+	}
+	if err := c.get(ctx, "/blocks/latest", &resp); err != nil {
+		return 0, err
+	}
+	return Slot(resp.Slot), nil
+}
+
+func (c *BlockfrostClient) SubmitTx(ctx context.Context, tx *Tx) (Hash32, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/tx/submit", strings.NewReader(string(tx.Bytes())))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("project_id", c.apiKey)
+	req.Header.Set("Content-Type", "application/cbor")
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("blockfrost: %s: %s", res.Status, body)
+	}
+
+	var txHash string
+	if err := json.NewDecoder(res.Body).Decode(&txHash); err != nil {
+		return nil, err
+	}
+	return NewHash32FromHex(txHash)
+}
+
+type blockfrostEpochParams struct {
+	MinFeeA          uint64 `json:"min_fee_a"`
+	MinFeeB          uint64 `json:"min_fee_b"`
+	KeyDeposit       string `json:"key_deposit"`
+	MaxValSize       string `json:"max_val_size"`
+	CoinsPerUtxoSize string `json:"coins_per_utxo_size"`
+}
+
+func (p *blockfrostEpochParams) toProtocolParams() *ProtocolParams {
+	return &ProtocolParams{
+		MinFeeA:          Coin(p.MinFeeA),
+		MinFeeB:          Coin(p.MinFeeB),
+		KeyDeposit:       parseCoin(p.KeyDeposit),
+		MaxValSize:       parseUint(p.MaxValSize),
+		CoinsPerUTxOByte: parseCoin(p.CoinsPerUtxoSize),
+	}
+}
+
+type blockfrostUTxO struct {
+	TxHash      string `json:"tx_hash"`
+	OutputIndex uint64 `json:"output_index"`
+	Amount      []struct {
+		Unit     string `json:"unit"`
+		Quantity string `json:"quantity"`
+	} `json:"amount"`
+}
+
+func (u *blockfrostUTxO) toUTxO(addr Address) (*UTxO, error) {
+	txHash, err := NewHash32FromHex(u.TxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	value := NewValue(0)
+	for _, amt := range u.Amount {
+		quantity := parseInt(amt.Quantity)
+		if amt.Unit == "lovelace" {
+			value.Coin = Coin(quantity)
+			continue
+		}
+		policyBytes, err := decodeHex(amt.Unit[:56])
+		if err != nil {
+			return nil, err
+		}
+		nameBytes, err := decodeHex(amt.Unit[56:])
+		if err != nil {
+			return nil, err
+		}
+		value.MultiAsset.Set(PolicyID(policyBytes), AssetName(nameBytes), quantity)
+	}
+
+	return &UTxO{
+		TxHash:  txHash,
+		Index:   u.OutputIndex,
+		Amount:  *value,
+		Address: addr,
+	}, nil
+}
+
+func parseCoin(s string) Coin   { return Coin(parseInt(s)) }
+func parseUint(s string) uint64 { return uint64(parseInt(s)) }
+
+func parseInt(s string) int64 {
+	var n int64
+	fmt.Sscanf(s, "%d", &n)
+	return n
+}
@@ -0,0 +1,50 @@
+package cardano
+
+import "testing"
+
+func TestBlockfrostUTxOToUTxODecodesLovelaceAndAssets(t *testing.T) {
+	u := blockfrostUTxO{
+		TxHash:      "0000000000000000000000000000000000000000000000000000000000000000",
+		OutputIndex: 1,
+		Amount: []struct {
+			Unit     string `json:"unit"`
+			Quantity string `json:"quantity"`
+		}{
+			{Unit: "lovelace", Quantity: "5000000"},
+			{Unit: "ab0000000000000000000000000000000000000000000000000000006e616d65", Quantity: "7"},
+		},
+	}
+
+	utxo, err := u.toUTxO(Address("addr_test_owner"))
+	if err != nil {
+		t.Fatalf("toUTxO: %v", err)
+	}
+	if utxo.Amount.Coin != 5_000_000 {
+		t.Fatalf("got coin %v, want 5000000", utxo.Amount.Coin)
+	}
+
+	policy, err := decodeHex("ab000000000000000000000000000000000000000000000000000000")
+	if err != nil {
+		t.Fatalf("decodeHex policy: %v", err)
+	}
+	if got := utxo.Amount.MultiAsset.Get(PolicyID(policy), AssetName("name")); got != 7 {
+		t.Fatalf("got asset quantity %v, want 7", got)
+	}
+}
+
+func TestBlockfrostUTxOToUTxOErrorsOnMalformedAssetUnit(t *testing.T) {
+	u := blockfrostUTxO{
+		TxHash:      "0000000000000000000000000000000000000000000000000000000000000000",
+		OutputIndex: 0,
+		Amount: []struct {
+			Unit     string `json:"unit"`
+			Quantity string `json:"quantity"`
+		}{
+			{Unit: "zz000000000000000000000000000000000000000000000000000000006e616d65", Quantity: "1"},
+		},
+	}
+
+	if _, err := u.toUTxO(Address("addr_test_owner")); err == nil {
+		t.Fatal("expected an error for a malformed asset unit")
+	}
+}
@@ -0,0 +1,39 @@
+package cardano
+
+import (
+	"errors"
+
+	"github.com/echovl/cardano-go/crypto"
+)
+
+// BootstrapWitness is the Shelley-era encoding of a Byron witness, required
+// to spend a UTxO sitting at a base58 Byron address. Unlike a VKeyWitness it
+// also carries the chain code and address attributes needed to reconstruct
+// the Byron address from the public key.
+type BootstrapWitness struct {
+	VKey       crypto.PubKey
+	Signature  []byte
+	ChainCode  []byte
+	Attributes []byte
+}
+
+// SignBootstrap queues xprv to sign for inputs sitting at addr, a Byron
+// (base58) address. Like Sign, signing itself is deferred until Build or
+// AddChangeIfNeeded call build(), so that the resulting BootstrapWitness is
+// produced against the transaction's final body hash.
+func (tb *TxBuilder) SignBootstrap(xprv crypto.XPrv, addr ByronAddress) error {
+	var hasByronInput bool
+	for _, input := range tb.tx.Body.Inputs {
+		if input.Address == Address(addr) {
+			hasByronInput = true
+			break
+		}
+	}
+	if !hasByronInput {
+		return errors.New("cardano: no input found for the given byron address")
+	}
+
+	tb.bootstrapKeys = append(tb.bootstrapKeys, bootstrapKey{xprv: xprv, addr: addr})
+
+	return nil
+}
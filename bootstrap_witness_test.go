@@ -0,0 +1,32 @@
+package cardano
+
+import (
+	"testing"
+
+	"github.com/echovl/cardano-go/crypto"
+)
+
+func TestSignBootstrapQueuesKeyForMatchingInput(t *testing.T) {
+	addr := ByronAddress("byron_test_addr")
+	tb := NewTxBuilder(testProtocol())
+	tb.AddInputs(&TxInput{Address: Address(addr)})
+
+	if err := tb.SignBootstrap(crypto.XPrv{}, addr); err != nil {
+		t.Fatalf("SignBootstrap: %v", err)
+	}
+	if len(tb.bootstrapKeys) != 1 {
+		t.Fatalf("got %d queued bootstrap keys, want 1", len(tb.bootstrapKeys))
+	}
+	if tb.bootstrapKeys[0].addr != addr {
+		t.Fatalf("queued key has addr %v, want %v", tb.bootstrapKeys[0].addr, addr)
+	}
+}
+
+func TestSignBootstrapErrorsWithoutMatchingInput(t *testing.T) {
+	tb := NewTxBuilder(testProtocol())
+	tb.AddInputs(&TxInput{Address: Address("addr_test_other")})
+
+	if err := tb.SignBootstrap(crypto.XPrv{}, ByronAddress("byron_test_addr")); err == nil {
+		t.Fatal("expected an error when no input matches the byron address")
+	}
+}
@@ -0,0 +1,186 @@
+package cardano
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// txEnvelope mirrors cardano-cli's TextEnvelope file format, the only way it
+// accepts a transaction.
+type txEnvelope struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	CborHex     string `json:"cborHex"`
+}
+
+func writeTempTxFile(tx *Tx) (string, error) {
+	f, err := os.CreateTemp("", "cardano-go-tx-*.json")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	envelope := txEnvelope{Type: "Tx BabbageEra", CborHex: fmt.Sprintf("%x", tx.Bytes())}
+	if err := json.NewEncoder(f).Encode(envelope); err != nil {
+		return "", err
+	}
+
+	return f.Name(), nil
+}
+
+// CardanoCLIClient is a Node implementation that shells out to the
+// cardano-cli binary against a local node socket, for users who already run
+// a full node and don't want to stand up Ogmios or depend on Blockfrost.
+type CardanoCLIClient struct {
+	socketPath string
+	network    string // one argument, e.g. "--mainnet" or "--testnet-magic=1097911063"
+}
+
+// NewCardanoCLIClient returns a CardanoCLIClient talking to the node socket
+// at socketPath on the given network.
+func NewCardanoCLIClient(socketPath, network string) *CardanoCLIClient {
+	return &CardanoCLIClient{socketPath: socketPath, network: network}
+}
+
+func (c *CardanoCLIClient) run(ctx context.Context, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "cardano-cli", args...)
+	cmd.Env = append(cmd.Env, "CARDANO_NODE_SOCKET_PATH="+c.socketPath)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("cardano-cli: %w: %s", err, stderr.String())
+	}
+	return stdout.Bytes(), nil
+}
+
+func (c *CardanoCLIClient) ProtocolParameters(ctx context.Context) (*ProtocolParams, error) {
+	out, err := c.run(ctx, "query", "protocol-parameters", c.network)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		TxFeePerByte        uint64 `json:"txFeePerByte"`
+		TxFeeFixed          uint64 `json:"txFeeFixed"`
+		StakeAddressDeposit uint64 `json:"stakeAddressDeposit"`
+		MaxValueSize        uint64 `json:"maxValueSize"`
+		UtxoCostPerByte     uint64 `json:"utxoCostPerByte"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		return nil, err
+	}
+
+	return &ProtocolParams{
+		MinFeeA:          Coin(result.TxFeePerByte),
+		MinFeeB:          Coin(result.TxFeeFixed),
+		KeyDeposit:       Coin(result.StakeAddressDeposit),
+		MaxValSize:       result.MaxValueSize,
+		CoinsPerUTxOByte: Coin(result.UtxoCostPerByte),
+	}, nil
+}
+
+func (c *CardanoCLIClient) UTxOs(ctx context.Context, addr Address) ([]*UTxO, error) {
+	out, err := c.run(ctx, "query", "utxo", "--address", addr.Bech32(), c.network, "--out-file", "/dev/stdout")
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]struct {
+		Value map[string]interface{} `json:"value"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		return nil, err
+	}
+
+	utxos := make([]*UTxO, 0, len(result))
+	for txIn, entry := range result {
+		txHashHex, index, err := splitTxIn(txIn)
+		if err != nil {
+			return nil, err
+		}
+		txHash, err := NewHash32FromHex(txHashHex)
+		if err != nil {
+			return nil, err
+		}
+
+		lovelace, _ := entry.Value["lovelace"].(float64)
+		value := NewValue(Coin(lovelace))
+		for policyHex, assets := range entry.Value {
+			if policyHex == "lovelace" {
+				continue
+			}
+			assetQuantities, ok := assets.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			policyBytes, err := decodeHex(policyHex)
+			if err != nil {
+				return nil, err
+			}
+			for assetNameHex, quantity := range assetQuantities {
+				nameBytes, err := decodeHex(assetNameHex)
+				if err != nil {
+					return nil, err
+				}
+				qty, _ := quantity.(float64)
+				value.MultiAsset.Set(PolicyID(policyBytes), AssetName(nameBytes), int64(qty))
+			}
+		}
+
+		utxos = append(utxos, &UTxO{
+			TxHash:  txHash,
+			Index:   index,
+			Amount:  *value,
+			Address: addr,
+		})
+	}
+	return utxos, nil
+}
+
+func (c *CardanoCLIClient) Tip(ctx context.Context) (Slot, error) {
+	out, err := c.run(ctx, "query", "tip", c.network)
+	if err != nil {
+		return 0, err
+	}
+
+	var result struct {
+		Slot uint64 `json:"slot"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		return 0, err
+	}
+	return Slot(result.Slot), nil
+}
+
+func (c *CardanoCLIClient) SubmitTx(ctx context.Context, tx *Tx) (Hash32, error) {
+	txFile, err := writeTempTxFile(tx)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(txFile)
+
+	if _, err := c.run(ctx, "transaction", "submit", "--tx-file", txFile, c.network); err != nil {
+		return nil, err
+	}
+
+	return tx.Hash()
+}
+
+func splitTxIn(txIn string) (hash string, index uint64, err error) {
+	for i := len(txIn) - 1; i >= 0; i-- {
+		if txIn[i] == '#' {
+			if _, err := fmt.Sscanf(txIn[i+1:], "%d", &index); err != nil {
+				return "", 0, fmt.Errorf("cardano-cli: malformed utxo key %q: %w", txIn, err)
+			}
+			return txIn[:i], index, nil
+		}
+	}
+	return "", 0, fmt.Errorf("cardano-cli: malformed utxo key %q", txIn)
+}
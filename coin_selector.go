@@ -0,0 +1,231 @@
+package cardano
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// CoinSelector picks a set of UTxOs able to cover the given outputs and
+// returns the selected inputs together with any leftover change outputs.
+// TxBuilder.AddChangeIfNeeded uses it to turn a UTxO pool into concrete
+// inputs instead of requiring the caller to pre-add them.
+type CoinSelector interface {
+	Select(outputs []*TxOutput, utxos []*UTxO) (selected []*TxInput, change []*TxOutput, err error)
+}
+
+// ErrUTxONotEnoughAmount is returned by a CoinSelector when the provided
+// UTxO pool cannot cover the requested outputs.
+var ErrUTxONotEnoughAmount = fmt.Errorf("cardano: not enough funds in utxo pool to satisfy outputs")
+
+// LargestFirst selects UTxOs in descending order of lovelace value until the
+// accumulated amount covers the sum of all requested outputs, lovelace and
+// every native asset alike; native asset quantities play no part in the
+// order. It is the simplest CoinSelector and is also used as the fallback
+// strategy by RandomImprove once its candidate pool is exhausted.
+type LargestFirst struct{}
+
+// NewLargestFirst returns a LargestFirst CoinSelector.
+func NewLargestFirst() *LargestFirst {
+	return &LargestFirst{}
+}
+
+func (ls *LargestFirst) Select(outputs []*TxOutput, utxos []*UTxO) ([]*TxInput, []*TxOutput, error) {
+	pool := sortedByCoinDesc(utxos)
+
+	target := targetValue(outputs)
+	selected, _, ok := takeUntilCovered(pool, target)
+	if !ok {
+		return nil, nil, ErrUTxONotEnoughAmount
+	}
+
+	return inputsFromUTxOs(selected), changeFromSelection(selected, target), nil
+}
+
+// sortedByCoinDesc returns a copy of utxos sorted by descending lovelace
+// value, the order LargestFirst (and RandomImprove's fallback) walks a pool
+// in to minimize the number of inputs selected.
+func sortedByCoinDesc(utxos []*UTxO) []*UTxO {
+	pool := append([]*UTxO{}, utxos...)
+	sort.Slice(pool, func(i, j int) bool {
+		return pool[i].Amount.Coin > pool[j].Amount.Coin
+	})
+	return pool
+}
+
+// RandomImprove implements the Cardano-standard "Random-Improve" algorithm:
+// outputs are processed from largest to smallest, and for each one UTxOs are
+// picked at random from the pool until the accumulated value reaches the
+// output's target. An improvement pass then keeps adding random UTxOs while
+// doing so brings the selection closer to (but not over) 2x the target, so
+// that change naturally comes out close in size to the outputs it pairs
+// with. If randomSelectMaxAttempts draws (or the whole pool) do not reach a
+// target, selection falls back to LargestFirst over the undrawn UTxOs for
+// the remainder.
+type RandomImprove struct {
+	rand *rand.Rand
+}
+
+// NewRandomImprove returns a RandomImprove CoinSelector seeded from src.
+func NewRandomImprove(src rand.Source) *RandomImprove {
+	return &RandomImprove{rand: rand.New(src)}
+}
+
+func (ri *RandomImprove) Select(outputs []*TxOutput, utxos []*UTxO) ([]*TxInput, []*TxOutput, error) {
+	sortedOutputs := append([]*TxOutput{}, outputs...)
+	sort.Slice(sortedOutputs, func(i, j int) bool {
+		return sortedOutputs[i].Amount.Coin > sortedOutputs[j].Amount.Coin
+	})
+
+	pool := append([]*UTxO{}, utxos...)
+	var selected []*UTxO
+
+	for _, out := range sortedOutputs {
+		target := out.Amount
+
+		picked, rest, ok := ri.randomSelect(pool, &target)
+		if !ok {
+			// Random draws gave up before reaching the target, fall back to
+			// largest-first (sorted descending, same as LargestFirst.Select)
+			// for the remaining coverage, so the fallback still minimizes
+			// input count instead of greedily consuming dust.
+			lfPicked, lfRest, ok := takeUntilCovered(sortedByCoinDesc(rest), subtractCovered(&target, picked))
+			if !ok {
+				return nil, nil, ErrUTxONotEnoughAmount
+			}
+			picked = append(picked, lfPicked...)
+			rest = lfRest
+		} else {
+			picked, rest = ri.improve(picked, rest, &target)
+		}
+
+		selected = append(selected, picked...)
+		pool = rest
+	}
+
+	return inputsFromUTxOs(selected), changeFromSelection(selected, targetValue(outputs)), nil
+}
+
+// randomSelectMaxAttempts bounds how many random draws randomSelect makes
+// before giving up on a single output and handing the remainder of the pool
+// to the largest-first fallback. Without a cap, randomSelect would only ever
+// fail by fully draining the pool, leaving the documented fallback nothing to
+// pick from; the cap leaves the undrawn UTxOs for it.
+const randomSelectMaxAttempts = 20
+
+// randomSelect draws UTxOs from pool at random until their accumulated
+// value covers target, returning the picked UTxOs and the remaining pool.
+// It gives up after randomSelectMaxAttempts draws even if pool isn't
+// exhausted, leaving the untouched remainder in rest for the caller's
+// largest-first fallback.
+func (ri *RandomImprove) randomSelect(pool []*UTxO, target *Value) (picked, rest []*UTxO, ok bool) {
+	rest = append([]*UTxO{}, pool...)
+	acc := NewValue(0)
+
+	for attempt := 0; !covers(acc, target); attempt++ {
+		if len(rest) == 0 || attempt >= randomSelectMaxAttempts {
+			return picked, rest, false
+		}
+		i := ri.rand.Intn(len(rest))
+		picked = append(picked, rest[i])
+		acc = acc.Add(&rest[i].Amount)
+		rest = append(rest[:i], rest[i+1:]...)
+	}
+
+	return picked, rest, true
+}
+
+// improve keeps adding random UTxOs from rest to picked while doing so moves
+// the accumulated value closer to, without exceeding, 2x target.
+func (ri *RandomImprove) improve(picked, rest []*UTxO, target *Value) ([]*UTxO, []*UTxO) {
+	acc := NewValue(0)
+	for _, u := range picked {
+		acc = acc.Add(&u.Amount)
+	}
+	upperBound := target.Coin * 2
+
+	for len(rest) > 0 {
+		i := ri.rand.Intn(len(rest))
+		candidate := rest[i]
+		newAcc := acc.Coin + candidate.Amount.Coin
+		if newAcc > upperBound {
+			break
+		}
+		if newAcc-upperBound/2 >= acc.Coin-upperBound/2 && acc.Coin >= upperBound {
+			break
+		}
+		picked = append(picked, candidate)
+		acc.Coin = newAcc
+		rest = append(rest[:i], rest[i+1:]...)
+	}
+
+	return picked, rest
+}
+
+func takeUntilCovered(pool []*UTxO, target *Value) (selected, rest []*UTxO, ok bool) {
+	acc := NewValue(0)
+	rest = append([]*UTxO{}, pool...)
+
+	for i := 0; i < len(rest); {
+		if covers(acc, target) {
+			break
+		}
+		selected = append(selected, rest[i])
+		acc = acc.Add(&rest[i].Amount)
+		rest = append(rest[:i], rest[i+1:]...)
+	}
+
+	return selected, rest, covers(acc, target)
+}
+
+// covers reports whether acc is enough to pay for target, lovelace and every
+// native asset independently.
+func covers(acc, target *Value) bool {
+	if acc.Coin < target.Coin {
+		return false
+	}
+	for policy, assets := range target.MultiAsset {
+		for name, quantity := range assets {
+			if acc.MultiAsset.Get(policy, name) < quantity {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func subtractCovered(target *Value, picked []*UTxO) *Value {
+	acc := NewValue(0)
+	for _, u := range picked {
+		acc = acc.Add(&u.Amount)
+	}
+	return target.Sub(acc)
+}
+
+func targetValue(outputs []*TxOutput) *Value {
+	total := NewValue(0)
+	for _, out := range outputs {
+		total = total.Add(&out.Amount)
+	}
+	return total
+}
+
+func inputsFromUTxOs(utxos []*UTxO) []*TxInput {
+	inputs := make([]*TxInput, len(utxos))
+	for i, u := range utxos {
+		inputs[i] = &TxInput{TxHash: u.TxHash, Index: u.Index, Amount: u.Amount, Address: u.Address}
+	}
+	return inputs
+}
+
+func changeFromSelection(selected []*UTxO, target *Value) []*TxOutput {
+	total := NewValue(0)
+	for _, u := range selected {
+		total = total.Add(&u.Amount)
+	}
+	change := total.Sub(target)
+	if change.Coin == 0 && change.OnlyCoin() {
+		return nil
+	}
+	return []*TxOutput{{Amount: *change}}
+}
@@ -0,0 +1,102 @@
+package cardano
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func utxoWithCoin(hash string, index uint64, coin Coin) *UTxO {
+	return &UTxO{
+		TxHash: Hash32(hash),
+		Index:  index,
+		Amount: *NewValue(coin),
+	}
+}
+
+func TestLargestFirstSelect(t *testing.T) {
+	utxos := []*UTxO{
+		utxoWithCoin("a", 0, 1_000_000),
+		utxoWithCoin("b", 0, 5_000_000),
+		utxoWithCoin("c", 0, 2_000_000),
+	}
+	outputs := []*TxOutput{{Amount: *NewValue(6_000_000)}}
+
+	selected, _, err := NewLargestFirst().Select(outputs, utxos)
+	if err != nil {
+		t.Fatalf("Select: %v", err)
+	}
+
+	// Largest-first should take the 5,000,000 UTxO first, then the next
+	// largest (2,000,000) to cover the 6,000,000 target.
+	if len(selected) != 2 {
+		t.Fatalf("got %d selected inputs, want 2", len(selected))
+	}
+	var total Coin
+	for _, in := range selected {
+		total += in.Amount.Coin
+	}
+	if total < 6_000_000 {
+		t.Fatalf("selected inputs only cover %v, want at least 6000000", total)
+	}
+}
+
+func TestLargestFirstSelectInsufficientFunds(t *testing.T) {
+	utxos := []*UTxO{utxoWithCoin("a", 0, 1_000_000)}
+	outputs := []*TxOutput{{Amount: *NewValue(2_000_000)}}
+
+	if _, _, err := NewLargestFirst().Select(outputs, utxos); err != ErrUTxONotEnoughAmount {
+		t.Fatalf("got err %v, want ErrUTxONotEnoughAmount", err)
+	}
+}
+
+func TestRandomImproveSelectCoversTarget(t *testing.T) {
+	utxos := []*UTxO{
+		utxoWithCoin("a", 0, 1_000_000),
+		utxoWithCoin("b", 0, 3_000_000),
+		utxoWithCoin("c", 0, 2_000_000),
+		utxoWithCoin("d", 0, 4_000_000),
+	}
+	outputs := []*TxOutput{{Amount: *NewValue(5_000_000)}}
+
+	selector := NewRandomImprove(rand.NewSource(1))
+	selected, _, err := selector.Select(outputs, utxos)
+	if err != nil {
+		t.Fatalf("Select: %v", err)
+	}
+
+	var total Coin
+	for _, in := range selected {
+		total += in.Amount.Coin
+	}
+	if total < 5_000_000 {
+		t.Fatalf("selected inputs only cover %v, want at least 5000000", total)
+	}
+}
+
+func TestRandomImproveFallsBackToLargestFirstWhenAttemptsExhausted(t *testing.T) {
+	// A pool that covers the target but only via its single largest UTxO;
+	// with seed 44, randomSelect burns through randomSelectMaxAttempts
+	// drawing the small ones before the deterministic fallback finishes the
+	// job.
+	utxos := make([]*UTxO, 0, randomSelectMaxAttempts+1)
+	for i := 0; i < randomSelectMaxAttempts; i++ {
+		utxos = append(utxos, utxoWithCoin("small", uint64(i), 1))
+	}
+	utxos = append(utxos, utxoWithCoin("big", 0, 10_000_000))
+
+	outputs := []*TxOutput{{Amount: *NewValue(10_000_000)}}
+	selector := NewRandomImprove(rand.NewSource(44))
+
+	selected, _, err := selector.Select(outputs, utxos)
+	if err != nil {
+		t.Fatalf("Select: %v", err)
+	}
+
+	var total Coin
+	for _, in := range selected {
+		total += in.Amount.Coin
+	}
+	if total < 10_000_000 {
+		t.Fatalf("selected inputs only cover %v, want at least 10000000", total)
+	}
+}
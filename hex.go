@@ -0,0 +1,18 @@
+package cardano
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// decodeHex decodes s, the hex encoding a Node backend uses for policy IDs
+// and asset names in its JSON/CLI responses, returning an error instead of
+// panicking so a flaky node or a future field-format change surfaces as a
+// normal error up the call chain rather than crashing the process.
+func decodeHex(s string) ([]byte, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("cardano: node returned malformed hex %q: %w", s, err)
+	}
+	return b, nil
+}
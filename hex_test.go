@@ -0,0 +1,25 @@
+package cardano
+
+import "testing"
+
+func TestDecodeHex(t *testing.T) {
+	got, err := decodeHex("deadbeef")
+	if err != nil {
+		t.Fatalf("decodeHex: %v", err)
+	}
+	want := []byte{0xde, 0xad, 0xbe, 0xef}
+	if len(got) != len(want) {
+		t.Fatalf("got %x, want %x", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %x, want %x", got, want)
+		}
+	}
+}
+
+func TestDecodeHexErrorsOnMalformedInput(t *testing.T) {
+	if _, err := decodeHex("not-hex"); err == nil {
+		t.Fatal("expected an error for malformed hex")
+	}
+}
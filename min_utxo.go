@@ -0,0 +1,30 @@
+package cardano
+
+// minUTXOConstantOverhead is the number of bytes Babbage charges for the
+// fixed parts of a UTxO entry (the TxIn plus non-value TxOut fields) on top
+// of the serialized Value, per the CIP-55 recommendation adopted at Alonzo.
+const minUTXOConstantOverhead = 160
+
+// minUTXO returns the minimum lovelace a TxOutput must carry, computed as
+// protocol.CoinsPerUTxOByte * (constant overhead + serialized value size).
+// This replaces the flat Shelley-era minUTXO now that outputs can carry
+// native assets, whose CBOR encoding dominates the output size.
+func minUTXO(output *TxOutput, protocol *ProtocolParams) Coin {
+	size := minUTXOConstantOverhead + valueSize(&output.Amount)
+	return protocol.CoinsPerUTxOByte * Coin(size)
+}
+
+// valueSize estimates the CBOR-encoded size in bytes of a Value, used both
+// for min-ADA calculation and to decide when a change output must be split
+// to stay under protocol.MaxValSize.
+func valueSize(value *Value) int {
+	if value.OnlyCoin() {
+		return 8
+	}
+
+	bytes, err := cborEnc.Marshal(value)
+	if err != nil {
+		return 8
+	}
+	return len(bytes)
+}
@@ -0,0 +1,60 @@
+package cardano
+
+import (
+	"context"
+)
+
+// Slot is a Cardano absolute slot number.
+type Slot uint64
+
+// Node is the interface TxBuilder uses to reach a running Cardano node (or a
+// service backed by one) in order to fetch the data needed to build a
+// transaction and to submit it once signed. Implementations wrap the
+// different ways of talking to a node: Ogmios' WebSocket JSON-RPC,
+// Blockfrost's REST API, and shelling out to cardano-cli against a local
+// node socket.
+type Node interface {
+	// ProtocolParameters returns the current protocol parameters.
+	ProtocolParameters(ctx context.Context) (*ProtocolParams, error)
+	// UTxOs returns the unspent transaction outputs sitting at addr.
+	UTxOs(ctx context.Context, addr Address) ([]*UTxO, error)
+	// Tip returns the current chain tip slot.
+	Tip(ctx context.Context) (Slot, error)
+	// SubmitTx submits a signed transaction to the network and returns its
+	// hash.
+	SubmitTx(ctx context.Context, tx *Tx) (Hash32, error)
+}
+
+// defaultTTLSlack is added to the node's tip when AddChangeIfNeeded derives a
+// default TTL from a Node, giving roughly two hours on mainnet (1s slots)
+// for the transaction to be included before it expires.
+const defaultTTLSlack = 7200
+
+// ensureTTL sets the transaction's TTL to the attached Node's current tip
+// plus defaultTTLSlack, unless a TTL has already been set explicitly.
+func (tb *TxBuilder) ensureTTL(ctx context.Context) error {
+	if tb.tx.Body.TTL != nil || tb.node == nil {
+		return nil
+	}
+	tip, err := tb.node.Tip(ctx)
+	if err != nil {
+		return err
+	}
+	tb.SetTTL(uint64(tip) + defaultTTLSlack)
+	return nil
+}
+
+// ensureInputs uses the attached Node to fetch the UTxOs sitting at
+// changeAddr and, via selector, adds as many as are needed to cover the
+// outputs added so far. It is a no-op if no Node is attached or the caller
+// already added their own inputs, so existing callers are unaffected.
+func (tb *TxBuilder) ensureInputs(ctx context.Context, changeAddr Address, selector CoinSelector) error {
+	if tb.node == nil || len(tb.tx.Body.Inputs) > 0 {
+		return nil
+	}
+	utxos, err := tb.node.UTxOs(ctx, changeAddr)
+	if err != nil {
+		return err
+	}
+	return tb.AddInputsFrom(utxos, selector)
+}
@@ -0,0 +1,149 @@
+package cardano
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gorilla/websocket"
+)
+
+// OgmiosClient is a Node implementation backed by an Ogmios
+// (https://ogmios.dev) instance, talking JSON-RPC over a WebSocket
+// connection to a local or remote cardano-node.
+type OgmiosClient struct {
+	url string
+}
+
+// NewOgmiosClient returns an OgmiosClient that dials url (e.g.
+// "ws://localhost:1337") for every request.
+func NewOgmiosClient(url string) *OgmiosClient {
+	return &OgmiosClient{url: url}
+}
+
+type ogmiosRequest struct {
+	JsonRPC string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+}
+
+type ogmiosResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (c *OgmiosClient) call(ctx context.Context, method string, params interface{}, out interface{}) error {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, c.url, nil)
+	if err != nil {
+		return fmt.Errorf("ogmios: dial: %w", err)
+	}
+	defer conn.Close()
+
+	if err := conn.WriteJSON(ogmiosRequest{JsonRPC: "2.0", Method: method, Params: params}); err != nil {
+		return fmt.Errorf("ogmios: write: %w", err)
+	}
+
+	var resp ogmiosResponse
+	if err := conn.ReadJSON(&resp); err != nil {
+		return fmt.Errorf("ogmios: read: %w", err)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("ogmios: %s", resp.Error.Message)
+	}
+
+	return json.Unmarshal(resp.Result, out)
+}
+
+func (c *OgmiosClient) ProtocolParameters(ctx context.Context) (*ProtocolParams, error) {
+	var result struct {
+		MinFeeCoefficient         Coin   `json:"minFeeCoefficient"`
+		MinFeeConstant            Coin   `json:"minFeeConstant"`
+		StakeCredentialDeposit    Coin   `json:"stakeCredentialDeposit"`
+		MaxValueSize              uint64 `json:"maxValueSize"`
+		MinUtxoDepositCoefficient Coin   `json:"minUtxoDepositCoefficient"`
+	}
+	if err := c.call(ctx, "queryLedgerState/protocolParameters", nil, &result); err != nil {
+		return nil, err
+	}
+	return &ProtocolParams{
+		MinFeeA:          result.MinFeeCoefficient,
+		MinFeeB:          result.MinFeeConstant,
+		KeyDeposit:       result.StakeCredentialDeposit,
+		MaxValSize:       result.MaxValueSize,
+		CoinsPerUTxOByte: result.MinUtxoDepositCoefficient,
+	}, nil
+}
+
+func (c *OgmiosClient) UTxOs(ctx context.Context, addr Address) ([]*UTxO, error) {
+	var result []struct {
+		Transaction struct {
+			ID string `json:"id"`
+		} `json:"transaction"`
+		Index uint64 `json:"index"`
+		// Value is keyed by "ada" for lovelace and by policy ID (hex) for
+		// every native asset, each mapping asset name (hex) to quantity.
+		Value map[string]map[string]uint64 `json:"value"`
+	}
+	if err := c.call(ctx, "queryLedgerState/utxo", map[string]interface{}{"addresses": []string{addr.Bech32()}}, &result); err != nil {
+		return nil, err
+	}
+
+	utxos := make([]*UTxO, len(result))
+	for i, u := range result {
+		txHash, err := NewHash32FromHex(u.Transaction.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		value := NewValue(Coin(u.Value["ada"]["lovelace"]))
+		for policyHex, assets := range u.Value {
+			if policyHex == "ada" {
+				continue
+			}
+			policyBytes, err := decodeHex(policyHex)
+			if err != nil {
+				return nil, err
+			}
+			for assetNameHex, quantity := range assets {
+				nameBytes, err := decodeHex(assetNameHex)
+				if err != nil {
+					return nil, err
+				}
+				value.MultiAsset.Set(PolicyID(policyBytes), AssetName(nameBytes), int64(quantity))
+			}
+		}
+
+		utxos[i] = &UTxO{
+			TxHash:  txHash,
+			Index:   u.Index,
+			Amount:  *value,
+			Address: addr,
+		}
+	}
+	return utxos, nil
+}
+
+func (c *OgmiosClient) Tip(ctx context.Context) (Slot, error) {
+	var result struct {
+		Slot uint64 `json:"slot"`
+	}
+	if err := c.call(ctx, "queryNetwork/tip", nil, &result); err != nil {
+		return 0, err
+	}
+	return Slot(result.Slot), nil
+}
+
+func (c *OgmiosClient) SubmitTx(ctx context.Context, tx *Tx) (Hash32, error) {
+	var result struct {
+		Transaction struct {
+			ID string `json:"id"`
+		} `json:"transaction"`
+	}
+	params := map[string]interface{}{"transaction": map[string]string{"cbor": fmt.Sprintf("%x", tx.Bytes())}}
+	if err := c.call(ctx, "submitTransaction", params, &result); err != nil {
+		return nil, err
+	}
+	return NewHash32FromHex(result.Transaction.ID)
+}
@@ -0,0 +1,182 @@
+package cardano
+
+import (
+	"errors"
+
+	"github.com/echovl/cardano-go/crypto"
+)
+
+// CostModels maps each supported Plutus language version to its cost model
+// parameters, as published in the protocol parameters. Its CBOR encoding is
+// one of the inputs to scriptDataHash.
+type CostModels map[string][]int64
+
+var errUnsupportedEvaluation = errors.New("cardano: node does not support ExUnits evaluation")
+
+// PlutusScript is the serialized (flat-encoded) bytes of a Plutus Core
+// script, the counterpart to the native Script type for script-locked
+// outputs that need actual computation rather than just signature checks.
+type PlutusScript []byte
+
+// Hash returns the blake2b-224 script hash used as the payment credential
+// of a script address and as the key under Redeemer/datum lookups.
+func (s PlutusScript) Hash() Hash28 {
+	return crypto.Blake2b224(append([]byte{plutusV1ScriptTag}, s...))
+}
+
+const plutusV1ScriptTag = 0x01
+
+// PlutusData is a CBOR-encodable Plutus data value, used both as the datum
+// locking a script output and as the argument passed to a redeemer.
+type PlutusData []byte
+
+// RedeemerTag identifies which part of the transaction a Redeemer applies
+// to, per the Alonzo CDDL.
+type RedeemerTag uint8
+
+const (
+	RedeemerTagSpend RedeemerTag = iota
+	RedeemerTagMint
+	RedeemerTagCert
+	RedeemerTagReward
+)
+
+// ExUnits is the Plutus execution budget consumed by a script run, priced in
+// the protocol's memory and CPU-step units.
+type ExUnits struct {
+	Mem   uint64
+	Steps uint64
+}
+
+// Rational is a non-negative fraction Num/Denom, used by the protocol to
+// publish priceMemory and priceSteps without floating point.
+type Rational struct {
+	Num   uint64
+	Denom uint64
+}
+
+// Redeemer supplies the arguments and execution budget for running the
+// Plutus script attached to an input, a mint, a certificate or a withdrawal.
+type Redeemer struct {
+	Tag     RedeemerTag
+	Index   uint64
+	Data    PlutusData
+	ExUnits ExUnits
+}
+
+// plutusInput bundles everything needed to spend a script-locked UTxO: the
+// input itself, the script that locks it, the datum it was created with,
+// and the redeemer that authorizes spending it.
+type plutusInput struct {
+	input    *TxInput
+	script   PlutusScript
+	datum    PlutusData
+	redeemer Redeemer
+}
+
+// AddPlutusScriptInput adds input as a script-locked input, attaching
+// script, datum and redeemer to the witness set and script data hash so the
+// transaction can be validated by the Plutus interpreter. Unlike with
+// AddInputs, the transaction then also needs at least one collateral input
+// (see AddCollateral) to cover the cost of phase-2 validation failure;
+// AddPlutusScriptInput does not check that one is present.
+func (tb *TxBuilder) AddPlutusScriptInput(input *TxInput, script PlutusScript, datum PlutusData, redeemer Redeemer) {
+	tb.AddInputs(input)
+	redeemer.Index = uint64(len(tb.tx.Body.Inputs) - 1)
+	tb.plutusInputs = append(tb.plutusInputs, plutusInput{
+		input:    input,
+		script:   script,
+		datum:    datum,
+		redeemer: redeemer,
+	})
+}
+
+// AddCollateral adds inputs as collateral, spent only if phase-2 (Plutus
+// script) validation fails. Collateral inputs must hold only ADA.
+func (tb *TxBuilder) AddCollateral(inputs ...*TxInput) {
+	tb.tx.Body.Collateral = append(tb.tx.Body.Collateral, inputs...)
+}
+
+// buildPlutusWitnesses assembles the Plutus-related parts of the witness set
+// and body ahead of hashing: the PlutusScripts, Datums and Redeemers in the
+// witness set, and ScriptDataHash in the body.
+func (tb *TxBuilder) buildPlutusWitnesses() error {
+	if len(tb.plutusInputs) == 0 {
+		return nil
+	}
+
+	datums := make([]PlutusData, 0, len(tb.plutusInputs))
+	redeemers := make([]Redeemer, 0, len(tb.plutusInputs))
+	scripts := make([]PlutusScript, 0, len(tb.plutusInputs))
+	for _, pi := range tb.plutusInputs {
+		scripts = append(scripts, pi.script)
+		datums = append(datums, pi.datum)
+		redeemers = append(redeemers, pi.redeemer)
+	}
+
+	tb.tx.WitnessSet.PlutusScripts = scripts
+	tb.tx.WitnessSet.PlutusData = datums
+	tb.tx.WitnessSet.Redeemers = redeemers
+
+	hash, err := scriptDataHash(redeemers, datums, tb.protocol.CostModels)
+	if err != nil {
+		return err
+	}
+	tb.tx.Body.ScriptDataHash = &hash
+
+	return nil
+}
+
+// scriptDataHash computes blake2b_256(redeemers || datums || language_views)
+// as specified for the Alonzo ScriptDataHash.
+func scriptDataHash(redeemers []Redeemer, datums []PlutusData, costModels CostModels) (Hash32, error) {
+	redeemersBytes, err := cborEnc.Marshal(redeemers)
+	if err != nil {
+		return nil, err
+	}
+	datumsBytes, err := cborEnc.Marshal(datums)
+	if err != nil {
+		return nil, err
+	}
+	languageViewsBytes, err := cborEnc.Marshal(costModels)
+	if err != nil {
+		return nil, err
+	}
+
+	preimage := append(append(redeemersBytes, datumsBytes...), languageViewsBytes...)
+	return crypto.Blake2b256(preimage), nil
+}
+
+// EvaluateExUnits ships the transaction to node for Plutus script evaluation
+// and fills in the real ExUnits for every redeemer added so far, replacing
+// whatever placeholder values were set on AddPlutusScriptInput. It should be
+// called once all inputs, outputs and scripts are final but before
+// AddChangeIfNeeded, since the fee depends on the ExUnits.
+func (tb *TxBuilder) EvaluateExUnits(node Node) error {
+	evaluator, ok := node.(ExUnitsEvaluator)
+	if !ok {
+		return errUnsupportedEvaluation
+	}
+
+	results, err := evaluator.EvaluateTx(tb.tx)
+	if err != nil {
+		return err
+	}
+
+	for i := range tb.plutusInputs {
+		if exUnits, ok := results[tb.plutusInputs[i].redeemer.Index]; ok {
+			tb.plutusInputs[i].redeemer.ExUnits = exUnits
+		}
+	}
+
+	return nil
+}
+
+// ExUnitsEvaluator is the optional interface of a Node backend that can run
+// the Plutus interpreter against a draft transaction to obtain accurate
+// ExUnits, as used by EvaluateExUnits. None of OgmiosClient, BlockfrostClient
+// and CardanoCLIClient implements it, so EvaluateExUnits returns
+// errUnsupportedEvaluation for each of them.
+type ExUnitsEvaluator interface {
+	EvaluateTx(tx *Tx) (map[uint64]ExUnits, error)
+}
@@ -0,0 +1,34 @@
+package cardano
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAddPlutusScriptInputSetsRedeemerIndexFromInputPosition(t *testing.T) {
+	tb := NewTxBuilder(testProtocol())
+	tb.AddInputs(&TxInput{Address: Address("addr_test_plain")})
+	tb.AddPlutusScriptInput(&TxInput{Address: Address("addr_test_script")}, PlutusScript{0x01}, PlutusData{0x02}, Redeemer{Tag: RedeemerTagSpend})
+
+	if len(tb.plutusInputs) != 1 {
+		t.Fatalf("got %d plutus inputs, want 1", len(tb.plutusInputs))
+	}
+	if got := tb.plutusInputs[0].redeemer.Index; got != 1 {
+		t.Fatalf("got redeemer index %d, want 1 (position of the script input)", got)
+	}
+}
+
+func TestEvaluateExUnitsErrorsWhenNodeDoesNotSupportEvaluation(t *testing.T) {
+	tb := NewTxBuilder(testProtocol())
+
+	if err := tb.EvaluateExUnits(noopNode{}); err != errUnsupportedEvaluation {
+		t.Fatalf("got err %v, want errUnsupportedEvaluation", err)
+	}
+}
+
+type noopNode struct{}
+
+func (noopNode) ProtocolParameters(ctx context.Context) (*ProtocolParams, error) { return nil, nil }
+func (noopNode) UTxOs(ctx context.Context, addr Address) ([]*UTxO, error)        { return nil, nil }
+func (noopNode) Tip(ctx context.Context) (Slot, error)                           { return 0, nil }
+func (noopNode) SubmitTx(ctx context.Context, tx *Tx) (Hash32, error)            { return nil, nil }
@@ -0,0 +1,76 @@
+package cardano
+
+import "errors"
+
+// SigningRequirement describes one signature that a built-but-unsigned
+// transaction still needs, identified by the key hash that must sign it and
+// the part of the body that requires it. It lets an external signer (a
+// hardware wallet, an air-gapped key, another party in a multisig) know
+// exactly what it is being asked to sign for.
+type SigningRequirement struct {
+	// KeyHash is the blake2b-224 hash of the verification key expected to
+	// produce the signature.
+	KeyHash Hash28
+	// Input is set when the requirement comes from spending a UTxO; it is
+	// nil for requirements coming from a certificate.
+	Input *TxInput
+	// Certificate is set when the requirement comes from a certificate
+	// (e.g. a stake deregistration needing the stake key's signature); it
+	// is nil for requirements coming from an input.
+	Certificate Certificate
+}
+
+// BuildUnsigned finalizes the transaction body without signing it (no VKey or
+// bootstrap witnesses are attached; Plutus scripts, datums and redeemers from
+// AddPlutusScriptInput are) and returns it alongside the list of signatures
+// still required.
+// The resulting Tx can be CBOR-encoded and handed to an external signer;
+// once every required VKeyWitness, BootstrapWitness or NativeScript witness
+// has been produced, the parts are merged back with AddVKeyWitness,
+// AddBootstrapWitness and AddNativeScriptWitness.
+func (tb *TxBuilder) BuildUnsigned() (*Tx, []SigningRequirement, error) {
+	if err := tb.buildBody(); err != nil {
+		return nil, nil, err
+	}
+
+	reqs := make([]SigningRequirement, 0, len(tb.tx.Body.Inputs)+len(tb.tx.Body.Certificates))
+	for _, input := range tb.tx.Body.Inputs {
+		keyHash, err := input.Address.KeyHash()
+		if err != nil {
+			return nil, nil, err
+		}
+		reqs = append(reqs, SigningRequirement{KeyHash: keyHash, Input: input})
+	}
+	for _, cert := range tb.tx.Body.Certificates {
+		keyHash, err := cert.StakeCredential.KeyHash()
+		if err != nil {
+			return nil, nil, err
+		}
+		reqs = append(reqs, SigningRequirement{KeyHash: keyHash, Certificate: cert})
+	}
+
+	return tb.tx, reqs, nil
+}
+
+// AddVKeyWitness attaches a signature produced by an external signer for one
+// of the SigningRequirements returned by BuildUnsigned.
+func (tx *Tx) AddVKeyWitness(witness VKeyWitness) {
+	tx.WitnessSet.VKeyWitnessSet = append(tx.WitnessSet.VKeyWitnessSet, witness)
+}
+
+// AddBootstrapWitness attaches a Byron-era witness produced by an external
+// signer for one of the SigningRequirements returned by BuildUnsigned.
+func (tx *Tx) AddBootstrapWitness(witness BootstrapWitness) {
+	tx.WitnessSet.BootstrapWitnessSet = append(tx.WitnessSet.BootstrapWitnessSet, witness)
+}
+
+// AddNativeScriptWitness attaches a native script to the witness set, e.g.
+// one backing a SigningRequirement derived from a multisig/timelock input.
+func (tx *Tx) AddNativeScriptWitness(script Script) {
+	tx.WitnessSet.NativeScripts = append(tx.WitnessSet.NativeScripts, script)
+}
+
+// ErrMissingWitness is the error for a Tx produced from a signing template
+// that is missing a witness for one of its SigningRequirements. No function
+// in this package returns it.
+var ErrMissingWitness = errors.New("cardano: missing witness for signing requirement")
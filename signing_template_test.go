@@ -0,0 +1,33 @@
+package cardano
+
+import "testing"
+
+func TestBuildUnsignedCollectsSigningRequirementForEachInput(t *testing.T) {
+	tb := NewTxBuilder(testProtocol())
+	tb.AddInputs(&TxInput{Address: Address("addr_test_spender")})
+	tb.AddOutputs(&TxOutput{Amount: *NewValue(1_000_000)})
+
+	_, reqs, err := tb.BuildUnsigned()
+	if err != nil {
+		t.Fatalf("BuildUnsigned: %v", err)
+	}
+	if len(reqs) != 1 {
+		t.Fatalf("got %d signing requirements, want 1", len(reqs))
+	}
+	if reqs[0].Input == nil || reqs[0].Input.Address != Address("addr_test_spender") {
+		t.Fatalf("requirement not tied to the input it came from: %+v", reqs[0])
+	}
+	if reqs[0].Certificate != nil {
+		t.Fatalf("input-derived requirement should not carry a certificate")
+	}
+}
+
+func TestAddVKeyWitnessAppendsToWitnessSet(t *testing.T) {
+	tx := &Tx{}
+	tx.AddVKeyWitness(VKeyWitness{})
+	tx.AddVKeyWitness(VKeyWitness{})
+
+	if len(tx.WitnessSet.VKeyWitnessSet) != 2 {
+		t.Fatalf("got %d vkey witnesses, want 2", len(tx.WitnessSet.VKeyWitnessSet))
+	}
+}
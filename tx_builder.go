@@ -1,6 +1,7 @@
 package cardano
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -9,20 +10,35 @@ import (
 )
 
 type TxBuilder struct {
-	tx       *Tx
-	protocol *ProtocolParams
-	pkeys    []crypto.PrvKey
+	tx            *Tx
+	protocol      *ProtocolParams
+	pkeys         []crypto.PrvKey
+	bootstrapKeys []bootstrapKey
+	node          Node
+	plutusInputs  []plutusInput
 }
 
-// NewTxBuilder returns a new instance of TxBuilder.
-func NewTxBuilder(protocol *ProtocolParams) *TxBuilder {
-	return &TxBuilder{
+type bootstrapKey struct {
+	xprv crypto.XPrv
+	addr ByronAddress
+}
+
+// NewTxBuilder returns a new instance of TxBuilder. node is optional: when
+// given, AddChangeIfNeeded sets the TTL to tip+7200 unless SetTTL was
+// called, and pulls UTxOs for the change address on its own when no inputs
+// were added beforehand.
+func NewTxBuilder(protocol *ProtocolParams, node ...Node) *TxBuilder {
+	tb := &TxBuilder{
 		protocol: protocol,
 		pkeys:    []crypto.PrvKey{},
 		tx: &Tx{
 			IsValid: true,
 		},
 	}
+	if len(node) > 0 {
+		tb.node = node[0]
+	}
+	return tb
 }
 
 // AddInputs adds inputs to the transaction being builded.
@@ -35,6 +51,19 @@ func (tb *TxBuilder) AddOutputs(outputs ...*TxOutput) {
 	tb.tx.Body.Outputs = append(tb.tx.Body.Outputs, outputs...)
 }
 
+// AddInputsFrom selects UTxOs from utxos using selector and adds them as
+// inputs, covering the outputs added so far. Any change produced by the
+// selection is not added to the transaction directly; it is left for
+// AddChangeIfNeeded, which recomputes it precisely once the fee is known.
+func (tb *TxBuilder) AddInputsFrom(utxos []*UTxO, selector CoinSelector) error {
+	inputs, _, err := selector.Select(tb.tx.Body.Outputs, utxos)
+	if err != nil {
+		return err
+	}
+	tb.AddInputs(inputs...)
+	return nil
+}
+
 // SetTtl sets the transaction's time to live.
 func (tb *TxBuilder) SetTTL(ttl uint64) {
 	tb.tx.Body.TTL = NewUint64(ttl)
@@ -53,13 +82,56 @@ func (tb *TxBuilder) AddCertificate(cert Certificate) {
 	tb.tx.Body.Certificates = append(tb.tx.Body.Certificates, cert)
 }
 
+// AddMint registers assets to be minted (or, with a negative quantity,
+// burned) under policy. The policy's script is collected into the witness
+// set so it is included in the final transaction.
+func (tb *TxBuilder) AddMint(policy Script, assets map[string]int64) error {
+	policyID := PolicyID(policy.Hash())
+
+	if tb.tx.Body.Mint == nil {
+		tb.tx.Body.Mint = NewMultiAsset()
+	}
+	for name, quantity := range assets {
+		tb.tx.Body.Mint.Set(policyID, AssetName(name), quantity)
+	}
+
+	tb.addNativeScript(policy)
+
+	return nil
+}
+
+func (tb *TxBuilder) addNativeScript(script Script) {
+	for _, s := range tb.tx.WitnessSet.NativeScripts {
+		if s.Hash() == script.Hash() {
+			return
+		}
+	}
+	tb.tx.WitnessSet.NativeScripts = append(tb.tx.WitnessSet.NativeScripts, script)
+}
+
 // AddChangeIfNeeded calculates the required fee for the transaction and adds
-// an aditional output for the change if there is any.
-// This assumes that the inputs-outputs are defined and signing keys are present.
-func (tb *TxBuilder) AddChangeIfNeeded(changeAddr Address) error {
-	inputAmount, outputAmount := tb.calculateAmounts()
+// one or more aditional outputs for the change if there is any. A single
+// change output is split into several when it would otherwise exceed
+// protocol.MaxValSize, which happens once a UTxO carries many native assets.
+// If a Node was passed to NewTxBuilder, it is used to default the TTL and,
+// when no inputs have been added yet, to pull UTxOs for changeAddr via
+// LargestFirst before computing the final change. Inputs that were added but
+// do not cover the outputs are not topped up from the Node.
+// This assumes that the outputs and signing keys are present.
+func (tb *TxBuilder) AddChangeIfNeeded(ctx context.Context, changeAddr Address) error {
+	if err := tb.ensureTTL(ctx); err != nil {
+		return err
+	}
+	if err := tb.ensureInputs(ctx, changeAddr, NewLargestFirst()); err != nil {
+		return err
+	}
+
+	inputValue, outputValue := tb.calculateAmounts()
 	totalDeposits := tb.totalDeposits()
 
+	mintedValue := &Value{MultiAsset: tb.tx.Body.Mint}
+	inputValue = inputValue.Add(mintedValue)
+
 	// Set a temporary realistic fee in order to serialize a valid transaction
 	tb.tx.Body.Fee = 200000
 	if _, err := tb.build(); err != nil {
@@ -67,53 +139,123 @@ func (tb *TxBuilder) AddChangeIfNeeded(changeAddr Address) error {
 	}
 
 	minFee := tb.calculateMinFee()
-	totalProduced := outputAmount + minFee + totalDeposits
+	totalProduced := outputValue.Add(NewValue(minFee + totalDeposits))
 
-	if inputAmount < totalProduced {
+	if inputValue.Coin < totalProduced.Coin {
 		return fmt.Errorf(
 			"insuficient input in transaction, got %v want atleast %v",
-			inputAmount,
-			totalProduced,
+			inputValue.Coin,
+			totalProduced.Coin,
 		)
 	}
 
-	if inputAmount == totalProduced {
+	changeValue := inputValue.Sub(totalProduced)
+	if !changeValue.MultiAsset.IsZero() && changeValue.MultiAsset.hasNegative() {
+		return fmt.Errorf("inputs do not cover outputs/mint for one or more assets")
+	}
+
+	if changeValue.Coin == 0 && changeValue.OnlyCoin() {
 		tb.tx.Body.Fee = minFee
 		return nil
 	}
 
-	change := inputAmount - totalProduced
-	changeOutput := &TxOutput{
-		Address: changeAddr,
-		Amount:  change,
+	changeOutputs, err := splitChange(changeValue, changeAddr, tb.protocol)
+	if err != nil {
+		return err
 	}
-	changeMinUTXO := minUTXO(changeOutput, tb.protocol)
-	if change < changeMinUTXO {
-		tb.tx.Body.Fee = minFee + change // burn change
-		return nil
+	for _, out := range changeOutputs {
+		if out.Amount.Coin < minUTXO(out, tb.protocol) {
+			return fmt.Errorf("change output below minimum UTxO value: %v", out.Amount.Coin)
+		}
 	}
 
-	tb.tx.Body.Outputs = append([]*TxOutput{changeOutput}, tb.tx.Body.Outputs...)
+	tb.tx.Body.Outputs = append(changeOutputs, tb.tx.Body.Outputs...)
 
 	newMinFee := tb.calculateMinFee()
-	if change+minFee-newMinFee < changeMinUTXO {
-		tb.tx.Body.Fee = minFee + change            // burn change
-		tb.tx.Body.Outputs = tb.tx.Body.Outputs[1:] // remove change output
-		return nil
+	feeDiff := newMinFee - minFee
+	if feeDiff > 0 {
+		if changeOutputs[0].Amount.Coin <= feeDiff {
+			tb.tx.Body.Outputs = tb.tx.Body.Outputs[len(changeOutputs):]
+			tb.tx.Body.Fee = minFee + changeValue.Coin
+			return nil
+		}
+		changeOutputs[0].Amount.Coin -= feeDiff
 	}
-
-	tb.tx.Body.Outputs[0].Amount = change + minFee - newMinFee
 	tb.tx.Body.Fee = newMinFee
 
 	return nil
 }
 
-func (tb *TxBuilder) calculateAmounts() (input, output Coin) {
+// splitChange distributes change into one output per changeAddr, adding
+// further outputs whenever the native-asset bundle would make a single
+// output exceed protocol.MaxValSize. Every extra output is topped up to its
+// own minUTXO out of the first output's lovelace, since an asset-only output
+// with no lovelace is not a valid UTxO.
+func splitChange(change *Value, changeAddr Address, protocol *ProtocolParams) ([]*TxOutput, error) {
+	bundles := []MultiAsset{NewMultiAsset()}
+	for policy, assets := range change.MultiAsset {
+		for name, quantity := range assets {
+			last := bundles[len(bundles)-1]
+			last.Set(policy, name, quantity)
+			if uint64(valueSize(&Value{MultiAsset: last})) > protocol.MaxValSize {
+				// Only undo adding this one (policy, name); anything else
+				// already accumulated under policy in an earlier iteration
+				// must stay in last.
+				delete(last[policy], name)
+				if len(last[policy]) == 0 {
+					delete(last, policy)
+				}
+				bundles = append(bundles, MultiAsset{policy: {name: quantity}})
+			}
+		}
+	}
+
+	outputs := make([]*TxOutput, len(bundles))
+	for i, bundle := range bundles {
+		outputs[i] = &TxOutput{
+			Address: changeAddr,
+			Amount:  Value{MultiAsset: bundle},
+		}
+	}
+
+	// All of the lovelace starts out on the first output. Every extra output
+	// needs enough of its own to satisfy minUTXO, so carve that out of the
+	// first output before returning.
+	outputs[0].Amount.Coin = change.Coin
+	for _, extra := range outputs[1:] {
+		extraMinCoin := minUTXO(extra, protocol)
+		if outputs[0].Amount.Coin < extraMinCoin {
+			return nil, fmt.Errorf(
+				"change of %v lovelace is not enough to satisfy minUTXO of %v extra asset-only output(s)",
+				change.Coin,
+				len(outputs)-1,
+			)
+		}
+		extra.Amount.Coin = extraMinCoin
+		outputs[0].Amount.Coin -= extraMinCoin
+	}
+
+	return outputs, nil
+}
+
+func (ma MultiAsset) hasNegative() bool {
+	for _, assets := range ma {
+		for _, quantity := range assets {
+			if quantity < 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (tb *TxBuilder) calculateAmounts() (input, output *Value) {
+	input, output = NewValue(0), NewValue(0)
 	for _, in := range tb.tx.Body.Inputs {
-		input += in.Amount
+		input = input.Add(&in.Amount)
 	}
 	for _, out := range tb.tx.Body.Outputs {
-		output += out.Amount
+		output = output.Add(&out.Amount)
 	}
 	return
 }
@@ -143,11 +285,27 @@ func (tb *TxBuilder) MinFee() (Coin, error) {
 	return minFee, nil
 }
 
-// CalculateFee computes the minimal fee required for the transaction.
+// CalculateFee computes the minimal fee required for the transaction,
+// including the Alonzo script-execution component when Plutus inputs are
+// present: fee = a*size + b + priceMem*sum(exUnits.Mem) + priceSteps*sum(exUnits.Steps).
 func (tb *TxBuilder) calculateMinFee() Coin {
 	txBytes := tb.tx.Bytes()
 	txLength := uint64(len(txBytes))
-	return tb.protocol.MinFeeA*Coin(txLength) + tb.protocol.MinFeeB
+	fee := tb.protocol.MinFeeA*Coin(txLength) + tb.protocol.MinFeeB
+
+	for _, pi := range tb.plutusInputs {
+		fee += scaleCoinByRat(Coin(pi.redeemer.ExUnits.Mem), tb.protocol.PriceMem)
+		fee += scaleCoinByRat(Coin(pi.redeemer.ExUnits.Steps), tb.protocol.PriceSteps)
+	}
+
+	return fee
+}
+
+// scaleCoinByRat scales units by the rational protocol price price (given as
+// numerator/denominator, matching how priceMemory/priceSteps are published
+// in the protocol parameters) and rounds up to the nearest lovelace.
+func scaleCoinByRat(units Coin, price Rational) Coin {
+	return Coin((uint64(units)*price.Num + price.Denom - 1) / price.Denom)
 }
 
 // Sign adds signing keys to create signatures for the witness set.
@@ -158,28 +316,32 @@ func (tb *TxBuilder) Sign(privateKeys ...crypto.PrvKey) error {
 
 // Build creates a new transaction using the inputs, outputs and keys provided.
 func (tb *TxBuilder) Build() (*Tx, error) {
-	inputAmount, outputAmount := tb.calculateAmounts()
-	totalProduced := outputAmount + tb.tx.Body.Fee + tb.totalDeposits()
+	inputValue, outputValue := tb.calculateAmounts()
+	totalProduced := outputValue.Add(NewValue(tb.tx.Body.Fee + tb.totalDeposits()))
 
-	if totalProduced > inputAmount {
+	if totalProduced.Coin > inputValue.Coin {
 		return nil, fmt.Errorf(
 			"insuficient input in transaction, got %v want %v",
-			inputAmount,
-			totalProduced,
+			inputValue.Coin,
+			totalProduced.Coin,
 		)
-	} else if totalProduced < inputAmount {
+	} else if totalProduced.Coin < inputValue.Coin {
 		return nil, fmt.Errorf(
 			"fee too small, got %v want %v",
 			tb.tx.Body.Fee,
-			inputAmount-totalProduced,
+			inputValue.Coin-totalProduced.Coin,
 		)
 	}
 
+	if leftover := inputValue.Sub(totalProduced); leftover.MultiAsset.hasNegative() {
+		return nil, fmt.Errorf("outputs/mint are not balanced by inputs for one or more assets")
+	}
+
 	return tb.build()
 }
 
 func (tb *TxBuilder) build() (*Tx, error) {
-	if len(tb.pkeys) == 0 {
+	if len(tb.pkeys) == 0 && len(tb.bootstrapKeys) == 0 {
 		return nil, errors.New("missing signing keys")
 	}
 
@@ -201,6 +363,17 @@ func (tb *TxBuilder) build() (*Tx, error) {
 	}
 	tb.tx.WitnessSet.VKeyWitnessSet = vkeyWitnsessSet
 
+	bootstrapWitnessSet := make([]BootstrapWitness, len(tb.bootstrapKeys))
+	for i, bk := range tb.bootstrapKeys {
+		bootstrapWitnessSet[i] = BootstrapWitness{
+			VKey:       bk.xprv.PubKey(),
+			Signature:  bk.xprv.Sign(txHash[:]),
+			ChainCode:  bk.xprv.ChainCode(),
+			Attributes: bk.addr.Attributes(),
+		}
+	}
+	tb.tx.WitnessSet.BootstrapWitnessSet = bootstrapWitnessSet
+
 	return tb.tx, nil
 }
 
@@ -214,5 +387,10 @@ func (tb *TxBuilder) buildBody() error {
 		auxHash32 := Hash32(auxHash[:])
 		tb.tx.Body.AuxiliaryDataHash = &auxHash32
 	}
+
+	if err := tb.buildPlutusWitnesses(); err != nil {
+		return err
+	}
+
 	return nil
 }
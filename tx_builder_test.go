@@ -0,0 +1,101 @@
+package cardano
+
+import "testing"
+
+func testProtocol() *ProtocolParams {
+	return &ProtocolParams{
+		MinFeeA:          44,
+		MinFeeB:          155381,
+		MaxValSize:       100,
+		CoinsPerUTxOByte: 4310,
+	}
+}
+
+func TestSplitChangeSingleOutputWhenUnderMaxValSize(t *testing.T) {
+	change := NewValue(5_000_000)
+	outputs, err := splitChange(change, Address("addr_test_change"), testProtocol())
+	if err != nil {
+		t.Fatalf("splitChange: %v", err)
+	}
+	if len(outputs) != 1 {
+		t.Fatalf("got %d outputs, want 1", len(outputs))
+	}
+	if outputs[0].Amount.Coin != change.Coin {
+		t.Fatalf("got coin %v, want %v", outputs[0].Amount.Coin, change.Coin)
+	}
+}
+
+func TestSplitChangeCarvesMinUTXOForExtraOutputs(t *testing.T) {
+	assets := NewMultiAsset()
+	for i := 0; i < 10; i++ {
+		assets.Set(PolicyID([]byte{byte(i)}), AssetName("token"), 1)
+	}
+	change := NewValueWithAssets(5_000_000, assets)
+	protocol := testProtocol()
+
+	outputs, err := splitChange(change, Address("addr_test_change"), protocol)
+	if err != nil {
+		t.Fatalf("splitChange: %v", err)
+	}
+	if len(outputs) < 2 {
+		t.Fatalf("expected change to be split across multiple outputs, got %d", len(outputs))
+	}
+
+	var spent Coin
+	for _, out := range outputs[1:] {
+		wantMin := minUTXO(out, protocol)
+		if out.Amount.Coin != wantMin {
+			t.Fatalf("extra output got %v lovelace, want its own minUTXO of %v", out.Amount.Coin, wantMin)
+		}
+		spent += out.Amount.Coin
+	}
+	if outputs[0].Amount.Coin != change.Coin-spent {
+		t.Fatalf("primary output got %v, want %v", outputs[0].Amount.Coin, change.Coin-spent)
+	}
+}
+
+func TestSplitChangeKeepsOtherAssetsUnderSamePolicyOnOverflow(t *testing.T) {
+	policy := PolicyID([]byte{0xAB})
+	assets := NewMultiAsset()
+	// Several assets under the same policy; MaxValSize is lowered so that
+	// only a couple of them fit in one bundle and the rest must overflow
+	// into new bundles.
+	for i := 0; i < 6; i++ {
+		assets.Set(policy, AssetName([]byte{byte(i)}), 1)
+	}
+	change := NewValueWithAssets(5_000_000, assets)
+	protocol := testProtocol()
+	protocol.MaxValSize = 30
+
+	outputs, err := splitChange(change, Address("addr_test_change"), protocol)
+	if err != nil {
+		t.Fatalf("splitChange: %v", err)
+	}
+	if len(outputs) < 2 {
+		t.Fatalf("expected change to overflow into multiple outputs, got %d", len(outputs))
+	}
+
+	got := NewMultiAsset()
+	for _, out := range outputs {
+		got = got.Add(out.Amount.MultiAsset)
+	}
+	for i := 0; i < 6; i++ {
+		name := AssetName([]byte{byte(i)})
+		if got.Get(policy, name) != 1 {
+			t.Fatalf("asset %v under policy lost across split outputs, got quantity %v", name, got.Get(policy, name))
+		}
+	}
+}
+
+func TestSplitChangeErrorsWhenChangeCantCoverExtraMinUTXO(t *testing.T) {
+	assets := NewMultiAsset()
+	for i := 0; i < 10; i++ {
+		assets.Set(PolicyID([]byte{byte(i)}), AssetName("token"), 1)
+	}
+	// Not enough lovelace to fund even one extra asset-only output.
+	change := NewValueWithAssets(1, assets)
+
+	if _, err := splitChange(change, Address("addr_test_change"), testProtocol()); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
@@ -0,0 +1,145 @@
+package cardano
+
+import "fmt"
+
+// PolicyID identifies the minting script of a native asset. It is the
+// blake2b-224 hash of the script that controls minting/burning of the asset.
+type PolicyID Hash28
+
+// AssetName is the human readable name of an asset within a policy. Cardano
+// allows up to 32 bytes, encoded as raw bytes in CBOR.
+type AssetName string
+
+// Bytes returns the raw bytes of the asset name.
+func (an AssetName) Bytes() []byte {
+	return []byte(an)
+}
+
+// MultiAsset represents the Mary-era `MultiAsset<uint64>` used both for
+// transaction outputs (always non-negative) and for the Mint field (signed,
+// since minting burns are represented as negative quantities).
+type MultiAsset map[PolicyID]map[AssetName]int64
+
+// NewMultiAsset returns an empty MultiAsset.
+func NewMultiAsset() MultiAsset {
+	return MultiAsset{}
+}
+
+// Set assigns the given quantity of policy/name to the asset bundle.
+func (ma MultiAsset) Set(policy PolicyID, name AssetName, quantity int64) {
+	assets, ok := ma[policy]
+	if !ok {
+		assets = map[AssetName]int64{}
+		ma[policy] = assets
+	}
+	assets[name] = quantity
+}
+
+// Get returns the quantity of policy/name, or 0 if absent.
+func (ma MultiAsset) Get(policy PolicyID, name AssetName) int64 {
+	assets, ok := ma[policy]
+	if !ok {
+		return 0
+	}
+	return assets[name]
+}
+
+// Add returns a new MultiAsset that is the sum of ma and other.
+func (ma MultiAsset) Add(other MultiAsset) MultiAsset {
+	sum := ma.clone()
+	for policy, assets := range other {
+		for name, quantity := range assets {
+			sum.Set(policy, name, sum.Get(policy, name)+quantity)
+		}
+	}
+	return sum.pruneZero()
+}
+
+// Sub returns a new MultiAsset that is ma minus other.
+func (ma MultiAsset) Sub(other MultiAsset) MultiAsset {
+	sum := ma.clone()
+	for policy, assets := range other {
+		for name, quantity := range assets {
+			sum.Set(policy, name, sum.Get(policy, name)-quantity)
+		}
+	}
+	return sum.pruneZero()
+}
+
+// IsZero returns true if every asset quantity is zero.
+func (ma MultiAsset) IsZero() bool {
+	for _, assets := range ma {
+		for _, quantity := range assets {
+			if quantity != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (ma MultiAsset) clone() MultiAsset {
+	clone := NewMultiAsset()
+	for policy, assets := range ma {
+		for name, quantity := range assets {
+			clone.Set(policy, name, quantity)
+		}
+	}
+	return clone
+}
+
+func (ma MultiAsset) pruneZero() MultiAsset {
+	for policy, assets := range ma {
+		for name, quantity := range assets {
+			if quantity == 0 {
+				delete(assets, name)
+			}
+		}
+		if len(assets) == 0 {
+			delete(ma, policy)
+		}
+	}
+	return ma
+}
+
+// Value is the Mary-era transaction amount: a lovelace quantity together with
+// an optional bundle of native assets.
+type Value struct {
+	Coin       Coin
+	MultiAsset MultiAsset
+}
+
+// NewValue returns a Value holding only lovelace.
+func NewValue(coin Coin) *Value {
+	return &Value{Coin: coin, MultiAsset: NewMultiAsset()}
+}
+
+// NewValueWithAssets returns a Value holding lovelace and native assets.
+func NewValueWithAssets(coin Coin, assets MultiAsset) *Value {
+	return &Value{Coin: coin, MultiAsset: assets}
+}
+
+// OnlyCoin reports whether the value carries no native assets.
+func (v *Value) OnlyCoin() bool {
+	return len(v.MultiAsset) == 0
+}
+
+// Add returns a new Value that is the sum of v and other.
+func (v *Value) Add(other *Value) *Value {
+	return &Value{
+		Coin:       v.Coin + other.Coin,
+		MultiAsset: v.MultiAsset.Add(other.MultiAsset),
+	}
+}
+
+// Sub returns a new Value that is v minus other.
+func (v *Value) Sub(other *Value) *Value {
+	return &Value{
+		Coin:       v.Coin - other.Coin,
+		MultiAsset: v.MultiAsset.Sub(other.MultiAsset),
+	}
+}
+
+func (v *Value) String() string {
+	return fmt.Sprintf("%v lovelace + %v assets", v.Coin, v.MultiAsset)
+}
@@ -0,0 +1,57 @@
+package cardano
+
+import "testing"
+
+const testPolicy PolicyID = "fedcba9876543210fedcba9876543210fedcba9876543210fedcba"
+
+func TestMultiAssetAddSub(t *testing.T) {
+	a := NewMultiAsset()
+	a.Set(testPolicy, "token", 10)
+
+	b := NewMultiAsset()
+	b.Set(testPolicy, "token", 4)
+
+	sum := a.Add(b)
+	if got := sum.Get(testPolicy, "token"); got != 14 {
+		t.Fatalf("Add: got %v, want 14", got)
+	}
+
+	diff := a.Sub(b)
+	if got := diff.Get(testPolicy, "token"); got != 6 {
+		t.Fatalf("Sub: got %v, want 6", got)
+	}
+}
+
+func TestMultiAssetSubToZeroIsPruned(t *testing.T) {
+	a := NewMultiAsset()
+	a.Set(testPolicy, "token", 10)
+
+	diff := a.Sub(a)
+	if !diff.IsZero() {
+		t.Fatalf("expected zeroed MultiAsset, got %v", diff)
+	}
+	if len(diff) != 0 {
+		t.Fatalf("expected zero quantities to be pruned, got %v", diff)
+	}
+}
+
+func TestValueAddSub(t *testing.T) {
+	assets := NewMultiAsset()
+	assets.Set(testPolicy, "token", 5)
+
+	v1 := NewValueWithAssets(1000, assets)
+	v2 := NewValue(300)
+
+	sum := v1.Add(v2)
+	if sum.Coin != 1300 {
+		t.Fatalf("Add: got coin %v, want 1300", sum.Coin)
+	}
+	if got := sum.MultiAsset.Get(testPolicy, "token"); got != 5 {
+		t.Fatalf("Add: got asset quantity %v, want 5", got)
+	}
+
+	diff := v1.Sub(v2)
+	if diff.Coin != 700 {
+		t.Fatalf("Sub: got coin %v, want 700", diff.Coin)
+	}
+}